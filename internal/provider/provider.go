@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+)
+
+// Provider returns a *schema.Provider.
+func Provider() (*schema.Provider, error) {
+	provider := &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_memorydb_subnet_group": memorydb.DataSourceSubnetGroup(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_memorydb_subnet_group": memorydb.ResourceSubnetGroup(),
+		},
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,30 @@
+package memorydb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	subnetGroupStatusAvailable = "available"
+	subnetGroupStatusDeleted   = "deleted"
+)
+
+func statusSubnetGroup(ctx context.Context, conn *memorydb.MemoryDB, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		group, err := FindSubnetGroupByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, subnetGroupStatusDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return group, subnetGroupStatusAvailable, nil
+	}
+}
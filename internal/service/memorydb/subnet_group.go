@@ -4,11 +4,13 @@ import (
 	"context"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +22,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	subnetGroupCreateTimeout = 5 * time.Minute
+	subnetGroupUpdateTimeout = 5 * time.Minute
+	subnetGroupDeleteTimeout = 10 * time.Minute
+	subnetGroupReadTimeout   = 5 * time.Minute
+)
+
 func ResourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSubnetGroupCreate,
@@ -31,7 +40,17 @@ func ResourceSubnetGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffValidateSubnetGroupSubnets,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(subnetGroupCreateTimeout),
+			Update: schema.DefaultTimeout(subnetGroupUpdateTimeout),
+			Delete: schema.DefaultTimeout(subnetGroupDeleteTimeout),
+			Read:   schema.DefaultTimeout(subnetGroupReadTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -93,16 +112,52 @@ func ResourceSubnetGroup() *schema.Resource {
 	}
 }
 
+func customizeDiffValidateSubnetGroupSubnets(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("subnet_ids") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("subnet_ids") {
+		// subnet_ids isn't fully known yet (e.g. the subnets are being created
+		// in the same apply) - the apply-time check in Create/Update will
+		// catch a real problem once the values are resolved.
+		return nil
+	}
+
+	subnetIDs := flex.ExpandStringValueSet(diff.Get("subnet_ids").(*schema.Set))
+
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := validateSubnetGroupSubnets(ctx, conn, subnetIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceSubnetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	conn := meta.(*conns.AWSClient).MemoryDBConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	subnetIDs := flex.ExpandStringValueSet(d.Get("subnet_ids").(*schema.Set))
+
+	if err := validateSubnetGroupSubnets(ctx, meta.(*conns.AWSClient).EC2Conn, subnetIDs); err != nil {
+		return diag.Errorf("error validating MemoryDB Subnet Group subnets: %s", err)
+	}
+
 	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &memorydb.CreateSubnetGroupInput{
 		Description:     aws.String(d.Get("description").(string)),
 		SubnetGroupName: aws.String(name),
-		SubnetIds:       flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		SubnetIds:       aws.StringSlice(subnetIDs),
 		Tags:            Tags(tags.IgnoreAWS()),
 	}
 
@@ -119,13 +174,24 @@ func resourceSubnetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceSubnetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
 	conn := meta.(*conns.AWSClient).MemoryDBConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
+		subnetIDs := flex.ExpandStringValueSet(d.Get("subnet_ids").(*schema.Set))
+
+		if d.HasChange("subnet_ids") {
+			if err := validateSubnetGroupSubnets(ctx, meta.(*conns.AWSClient).EC2Conn, subnetIDs); err != nil {
+				return diag.Errorf("error validating MemoryDB Subnet Group subnets: %s", err)
+			}
+		}
+
 		input := &memorydb.UpdateSubnetGroupInput{
 			Description:     aws.String(d.Get("description").(string)),
 			SubnetGroupName: aws.String(d.Id()),
-			SubnetIds:       flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+			SubnetIds:       aws.StringSlice(subnetIDs),
 		}
 
 		log.Printf("[DEBUG] Updating MemoryDB Subnet Group: %s", input)
@@ -148,6 +214,9 @@ func resourceSubnetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceSubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
 	conn := meta.(*conns.AWSClient).MemoryDBConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -197,12 +266,19 @@ func resourceSubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceSubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
 	conn := meta.(*conns.AWSClient).MemoryDBConn
 
 	log.Printf("[DEBUG] Deleting MemoryDB Subnet Group: (%s)", d.Id())
-	_, err := conn.DeleteSubnetGroupWithContext(ctx, &memorydb.DeleteSubnetGroupInput{
-		SubnetGroupName: aws.String(d.Id()),
-	})
+	// The parent MemoryDB cluster may still be finalizing its own deletion,
+	// which keeps the subnet group in use for a short while longer.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSubnetGroupWithContext(ctx, &memorydb.DeleteSubnetGroupInput{
+			SubnetGroupName: aws.String(d.Id()),
+		})
+	}, memorydb.ErrCodeSubnetGroupInUseFault)
 
 	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeSubnetGroupNotFoundFault) {
 		return nil
@@ -212,5 +288,9 @@ func resourceSubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error deleting MemoryDB Subnet Group (%s): %s", d.Id(), err)
 	}
 
+	if err := waitSubnetGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MemoryDB Subnet Group (%s) delete: %s", d.Id(), err)
+	}
+
 	return nil
 }
@@ -0,0 +1,44 @@
+package memorydb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// validateSubnetGroupSubnets ensures that the subnets backing a MemoryDB subnet
+// group all belong to the same VPC and span at least two Availability Zones, as
+// required to create a multi-AZ MemoryDB cluster.
+func validateSubnetGroupSubnets(ctx context.Context, conn *ec2.EC2, subnetIDs []string) error {
+	output, err := conn.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error describing subnets (%v): %w", subnetIDs, err)
+	}
+
+	vpcIDs := make(map[string][]string)
+	azs := make(map[string][]string)
+
+	for _, subnet := range output.Subnets {
+		vpcID := aws.StringValue(subnet.VpcId)
+		az := aws.StringValue(subnet.AvailabilityZone)
+		id := aws.StringValue(subnet.SubnetId)
+
+		vpcIDs[vpcID] = append(vpcIDs[vpcID], id)
+		azs[az] = append(azs[az], id)
+	}
+
+	if len(vpcIDs) > 1 {
+		return fmt.Errorf("subnet_ids must all belong to the same VPC, but found subnets from %d VPCs: %v", len(vpcIDs), vpcIDs)
+	}
+
+	if len(azs) < 2 {
+		return fmt.Errorf("subnet_ids must span at least 2 Availability Zones, but found subnets from only %d: %v", len(azs), azs)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package memorydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitSubnetGroupDeleted(ctx context.Context, conn *memorydb.MemoryDB, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{subnetGroupStatusAvailable},
+		Target:  []string{},
+		Refresh: statusSubnetGroup(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}